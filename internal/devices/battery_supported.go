@@ -0,0 +1,49 @@
+//go:build linux || darwin || windows
+
+package devices
+
+import (
+	"time"
+
+	"github.com/distatus/battery"
+)
+
+// Battery reads the primary battery via distatus/battery, which covers
+// Linux (sysfs), Darwin (SMC via cgo) and Windows (WMI).
+func Battery() (BatteryStatus, error) {
+	batteries, err := battery.GetAll()
+	if err != nil {
+		// battery.Errors carries one entry per battery and fires on any
+		// partial read (e.g. Design capacity or Voltage unreadable on
+		// Linux); the populated fields in batteries[0] are still usable,
+		// so only a fatal error (no usable battery data at all) bails out.
+		if _, partial := err.(battery.Errors); !partial {
+			return BatteryStatus{}, err
+		}
+	}
+	if len(batteries) == 0 {
+		return BatteryStatus{}, nil
+	}
+
+	b := batteries[0]
+	status := BatteryStatus{Present: true}
+	if b.Full > 0 {
+		status.Percent = b.Current / b.Full * 100
+	}
+
+	switch b.State.Raw {
+	case battery.Charging:
+		status.State = BatteryCharging
+	case battery.Discharging:
+		status.State = BatteryDischarging
+		if b.ChargeRate > 0 {
+			status.TimeToEmpty = time.Duration(b.Current/b.ChargeRate*3600) * time.Second
+		}
+	case battery.Full:
+		status.State = BatteryFull
+	default:
+		status.State = BatteryUnknown
+	}
+
+	return status, nil
+}
@@ -0,0 +1,9 @@
+//go:build !linux && !darwin && !windows
+
+package devices
+
+// Battery reports no battery on platforms without a distatus/battery
+// backend (e.g. NetBSD, OpenBSD).
+func Battery() (BatteryStatus, error) {
+	return BatteryStatus{}, nil
+}
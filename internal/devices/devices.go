@@ -0,0 +1,34 @@
+// Package devices reads temperature sensors and battery status. The
+// underlying backends differ per platform (Linux hwmon, Darwin SMC,
+// Windows WMI), so implementations that need it are split by build tag;
+// callers always get a zero value rather than an error when a sensor or
+// battery simply isn't present on the host.
+package devices
+
+import "time"
+
+// TemperatureSensor is a single named sensor reading in degrees Celsius.
+type TemperatureSensor struct {
+	Name    string
+	Celsius float64
+}
+
+// BatteryState mirrors the charge states reported by the OS.
+type BatteryState string
+
+const (
+	BatteryCharging    BatteryState = "charging"
+	BatteryDischarging BatteryState = "discharging"
+	BatteryFull        BatteryState = "full"
+	BatteryUnknown     BatteryState = "unknown"
+)
+
+// BatteryStatus describes the primary battery, if any. Present is false on
+// hosts with no battery (most desktops and servers), in which case the
+// remaining fields are zero values.
+type BatteryStatus struct {
+	Present     bool
+	Percent     float64
+	State       BatteryState
+	TimeToEmpty time.Duration
+}
@@ -0,0 +1,26 @@
+package devices
+
+import (
+	"context"
+
+	"github.com/shirou/gopsutil/v4/sensors"
+)
+
+// Temperatures reads every sensor gopsutil can find for the current
+// platform (hwmon on Linux, SMC on Darwin, WMI on Windows). gopsutil
+// sometimes returns both a partial sensor list and a warning error (e.g. one
+// unreadable hwmon path among many); that's treated as success here rather
+// than discarding the sensors it did manage to read.
+func Temperatures(ctx context.Context) ([]TemperatureSensor, error) {
+	stats, err := sensors.TemperaturesWithContext(ctx)
+	if err != nil && len(stats) == 0 {
+		return nil, err
+	}
+
+	sensors := make([]TemperatureSensor, 0, len(stats))
+	for _, s := range stats {
+		sensors = append(sensors, TemperatureSensor{Name: s.SensorKey, Celsius: s.Temperature})
+	}
+
+	return sensors, nil
+}
@@ -0,0 +1,139 @@
+// Package exporter mirrors collected system metrics into Prometheus
+// Gauge/GaugeVec registrations so they can be scraped over HTTP via
+// promhttp, independent of whatever is driving the TUI.
+package exporter
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// DiskPartition is the subset of disk usage needed for the
+// sysmon_disk_used_bytes/sysmon_disk_total_bytes gauges.
+type DiskPartition struct {
+	Mount string
+	FS    string
+	Used  uint64
+	Total uint64
+}
+
+// NetInterface is the subset of network throughput needed for the
+// sysmon_net_*_bytes_per_second gauges.
+type NetInterface struct {
+	Name      string
+	RxBytesPS float64
+	TxBytesPS float64
+}
+
+// Exporter holds the Prometheus registrations for every metric sysmon
+// collects.
+type Exporter struct {
+	registry *prometheus.Registry
+
+	cpuPercent *prometheus.GaugeVec
+	memUsed    prometheus.Gauge
+	memTotal   prometheus.Gauge
+
+	diskUsed  *prometheus.GaugeVec
+	diskTotal *prometheus.GaugeVec
+
+	netRxBytesPS *prometheus.GaugeVec
+	netTxBytesPS *prometheus.GaugeVec
+
+	processCount prometheus.Gauge
+}
+
+// New creates an Exporter with all gauges registered against a fresh
+// registry.
+func New() *Exporter {
+	e := &Exporter{
+		registry: prometheus.NewRegistry(),
+		cpuPercent: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "sysmon_cpu_percent",
+			Help: "Per-core CPU utilization percentage.",
+		}, []string{"core"}),
+		memUsed: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "sysmon_mem_used_bytes",
+			Help: "Memory currently in use, in bytes.",
+		}),
+		memTotal: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "sysmon_mem_total_bytes",
+			Help: "Total installed memory, in bytes.",
+		}),
+		diskUsed: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "sysmon_disk_used_bytes",
+			Help: "Used disk space per mounted partition, in bytes.",
+		}, []string{"mount", "fs"}),
+		diskTotal: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "sysmon_disk_total_bytes",
+			Help: "Total disk space per mounted partition, in bytes.",
+		}, []string{"mount", "fs"}),
+		netRxBytesPS: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "sysmon_net_rx_bytes_per_second",
+			Help: "Inbound network throughput per interface, in bytes per second.",
+		}, []string{"interface"}),
+		netTxBytesPS: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "sysmon_net_tx_bytes_per_second",
+			Help: "Outbound network throughput per interface, in bytes per second.",
+		}, []string{"interface"}),
+		processCount: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "sysmon_process_count",
+			Help: "Number of processes observed in the last collection.",
+		}),
+	}
+
+	e.registry.MustRegister(
+		e.cpuPercent,
+		e.memUsed,
+		e.memTotal,
+		e.diskUsed,
+		e.diskTotal,
+		e.netRxBytesPS,
+		e.netTxBytesPS,
+		e.processCount,
+	)
+
+	return e
+}
+
+// Handler returns the HTTP handler to mount at /metrics.
+func (e *Exporter) Handler() http.Handler {
+	return promhttp.HandlerFor(e.registry, promhttp.HandlerOpts{})
+}
+
+// UpdateCPU sets the per-core CPU percent gauges.
+func (e *Exporter) UpdateCPU(percentUsage []float64) {
+	for i, p := range percentUsage {
+		e.cpuPercent.WithLabelValues(strconv.Itoa(i)).Set(p)
+	}
+}
+
+// UpdateMem sets the memory gauges.
+func (e *Exporter) UpdateMem(used, total uint64) {
+	e.memUsed.Set(float64(used))
+	e.memTotal.Set(float64(total))
+}
+
+// UpdateDisk sets the disk gauges for every mounted partition.
+func (e *Exporter) UpdateDisk(partitions []DiskPartition) {
+	for _, p := range partitions {
+		e.diskUsed.WithLabelValues(p.Mount, p.FS).Set(float64(p.Used))
+		e.diskTotal.WithLabelValues(p.Mount, p.FS).Set(float64(p.Total))
+	}
+}
+
+// UpdateNet sets the network throughput gauges for every interface.
+func (e *Exporter) UpdateNet(interfaces []NetInterface) {
+	for _, iface := range interfaces {
+		e.netRxBytesPS.WithLabelValues(iface.Name).Set(iface.RxBytesPS)
+		e.netTxBytesPS.WithLabelValues(iface.Name).Set(iface.TxBytesPS)
+	}
+}
+
+// UpdateProcessCount sets the process count gauge.
+func (e *Exporter) UpdateProcessCount(n int) {
+	e.processCount.Set(float64(n))
+}
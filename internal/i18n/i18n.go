@@ -0,0 +1,97 @@
+// Package i18n looks up user-visible strings from locale bundles rather
+// than hard-coding them in English, so the TUI can be read in the user's
+// own language.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+)
+
+//go:embed locales/*.json
+var localeFS embed.FS
+
+const defaultLocale = "en_US"
+
+// Bundle is a loaded set of key/value translations for one locale.
+type Bundle struct {
+	locale string
+	values map[string]string
+}
+
+// DetectLocale mirrors jibber_jabber's approach: read LC_ALL then LANG,
+// strip the encoding/modifier suffix (e.g. "en_US.UTF-8" -> "en_US"), and
+// fall back to en_US when nothing usable is set.
+func DetectLocale() string {
+	for _, env := range []string{"LC_ALL", "LANG"} {
+		if raw := os.Getenv(env); raw != "" {
+			if locale := normalizeLocale(raw); locale != "" {
+				return locale
+			}
+		}
+	}
+	return defaultLocale
+}
+
+func normalizeLocale(raw string) string {
+	raw = strings.SplitN(raw, ".", 2)[0]
+	raw = strings.SplitN(raw, "@", 2)[0]
+	if raw == "" || raw == "C" || raw == "POSIX" {
+		return ""
+	}
+	return raw
+}
+
+// Load reads the bundle for locale, falling back to en_US when that locale
+// has no bundle shipped.
+func Load(locale string) (*Bundle, error) {
+	data, err := localeFS.ReadFile("locales/" + locale + ".json")
+	if err != nil {
+		locale = defaultLocale
+		data, err = localeFS.ReadFile("locales/" + defaultLocale + ".json")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var values map[string]string
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+
+	return &Bundle{locale: locale, values: values}, nil
+}
+
+// Value looks up key, returning the key itself if the bundle has no
+// translation for it.
+func (b *Bundle) Value(key string) string {
+	if v, ok := b.values[key]; ok {
+		return v
+	}
+	return key
+}
+
+// Locale returns the locale this bundle was actually loaded for, which may
+// differ from what was requested if it fell back to en_US.
+func (b *Bundle) Locale() string {
+	return b.locale
+}
+
+// ListLocales returns every locale with a shipped bundle, sorted.
+func ListLocales() ([]string, error) {
+	entries, err := localeFS.ReadDir("locales")
+	if err != nil {
+		return nil, err
+	}
+
+	locales := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		locales = append(locales, strings.TrimSuffix(entry.Name(), ".json"))
+	}
+	sort.Strings(locales)
+
+	return locales, nil
+}
@@ -0,0 +1,73 @@
+package i18n
+
+import "testing"
+
+func TestNormalizeLocale(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{"encoding suffix stripped", "en_US.UTF-8", "en_US"},
+		{"modifier suffix stripped", "ca_ES@valencia", "ca_ES"},
+		{"plain locale unchanged", "es_ES", "es_ES"},
+		{"posix is not a usable locale", "POSIX", ""},
+		{"C is not a usable locale", "C", ""},
+		{"empty is not a usable locale", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeLocale(tt.raw); got != tt.want {
+				t.Errorf("normalizeLocale(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectLocale(t *testing.T) {
+	tests := []struct {
+		name  string
+		lcAll string
+		lang  string
+		want  string
+	}{
+		{"LC_ALL takes priority over LANG", "es_ES.UTF-8", "en_US", "es_ES"},
+		{"falls back to LANG when LC_ALL is unset", "", "es_ES.UTF-8", "es_ES"},
+		{"falls back to en_US when nothing usable is set", "", "", defaultLocale},
+		{"falls back to en_US when only POSIX is set", "POSIX", "C", defaultLocale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("LC_ALL", tt.lcAll)
+			t.Setenv("LANG", tt.lang)
+			if got := DetectLocale(); got != tt.want {
+				t.Errorf("DetectLocale() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFallsBackToDefaultLocale(t *testing.T) {
+	b, err := Load("xx_XX")
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if b.Locale() != defaultLocale {
+		t.Errorf("Locale() = %q, want %q", b.Locale(), defaultLocale)
+	}
+}
+
+func TestBundleValueFallsBackToKey(t *testing.T) {
+	b, err := Load(defaultLocale)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if got := b.Value("widget.cpu"); got == "widget.cpu" {
+		t.Errorf("Value(%q) returned the key itself, want a translation", "widget.cpu")
+	}
+	if got := b.Value("no.such.key"); got != "no.such.key" {
+		t.Errorf("Value(%q) = %q, want the key itself", "no.such.key", got)
+	}
+}
@@ -0,0 +1,19 @@
+// Package metrics hosts the collectors that poll gopsutil for system data
+// beyond the CPU/memory/disk metrics already gathered in main. Each
+// collector implements Collector so new metric sources can be added without
+// changing how the model drives collection.
+package metrics
+
+import "context"
+
+// Collector gathers a single typed snapshot of a metric source. T is the
+// snapshot type produced by a concrete collector (NetMetrics, ProcessMetrics,
+// ...).
+type Collector[T any] interface {
+	Collect(ctx context.Context) (T, error)
+}
+
+var (
+	_ Collector[NetMetrics]     = (*NetCollector)(nil)
+	_ Collector[ProcessMetrics] = (*ProcessCollector)(nil)
+)
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/shirou/gopsutil/v4/net"
+)
+
+// NetInterfaceMetrics describes the throughput of a single network
+// interface, computed as a delta between two consecutive collections.
+type NetInterfaceMetrics struct {
+	Name      string
+	RxBytesPS float64
+	TxBytesPS float64
+}
+
+// NetMetrics is a snapshot of all network interfaces at a point in time.
+type NetMetrics struct {
+	Interfaces []NetInterfaceMetrics
+}
+
+// NetCollector collects per-interface RX/TX rates. Rates are delta-based, so
+// the first Collect call always reports zero until a previous sample exists.
+type NetCollector struct {
+	prev   map[string]net.IOCountersStat
+	prevAt time.Time
+}
+
+// NewNetCollector returns a ready-to-use NetCollector.
+func NewNetCollector() *NetCollector {
+	return &NetCollector{}
+}
+
+func (c *NetCollector) Collect(ctx context.Context) (NetMetrics, error) {
+	counters, err := net.IOCountersWithContext(ctx, true)
+	if err != nil {
+		return NetMetrics{}, err
+	}
+
+	now := time.Now()
+	elapsed := now.Sub(c.prevAt).Seconds()
+
+	interfaces := make([]NetInterfaceMetrics, 0, len(counters))
+	for _, counter := range counters {
+		var rx, tx float64
+		if prev, ok := c.prev[counter.Name]; ok && elapsed > 0 {
+			rx = rateSince(counter.BytesRecv, prev.BytesRecv, elapsed)
+			tx = rateSince(counter.BytesSent, prev.BytesSent, elapsed)
+		}
+		interfaces = append(interfaces, NetInterfaceMetrics{Name: counter.Name, RxBytesPS: rx, TxBytesPS: tx})
+	}
+
+	prev := make(map[string]net.IOCountersStat, len(counters))
+	for _, counter := range counters {
+		prev[counter.Name] = counter
+	}
+	c.prev = prev
+	c.prevAt = now
+
+	return NetMetrics{Interfaces: interfaces}, nil
+}
+
+// rateSince returns the per-second rate between two counter readings, or
+// zero if current < prev. Counters reset to 0 when an interface is brought
+// down and back up, so a naive current-prev would underflow (these are
+// uint64s) and report a huge bogus spike instead of the reset.
+func rateSince(current, prev uint64, elapsed float64) float64 {
+	if current < prev {
+		return 0
+	}
+	return float64(current-prev) / elapsed
+}
@@ -0,0 +1,25 @@
+package metrics
+
+import "testing"
+
+func TestRateSince(t *testing.T) {
+	tests := []struct {
+		name    string
+		current uint64
+		prev    uint64
+		elapsed float64
+		want    float64
+	}{
+		{"steady increase", 2000, 1000, 2, 500},
+		{"no change", 1000, 1000, 1, 0},
+		{"counter reset on interface flap", 100, 5000, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rateSince(tt.current, tt.prev, tt.elapsed); got != tt.want {
+				t.Errorf("rateSince(%d, %d, %v) = %v, want %v", tt.current, tt.prev, tt.elapsed, got, tt.want)
+			}
+		})
+	}
+}
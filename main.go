@@ -1,8 +1,11 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
@@ -12,12 +15,43 @@ import (
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/shirou/gopsutil/cpu"
-	"github.com/shirou/gopsutil/disk"
-	"github.com/shirou/gopsutil/mem"
+	"github.com/shirou/gopsutil/v4/cpu"
+	"github.com/shirou/gopsutil/v4/disk"
+	"github.com/shirou/gopsutil/v4/mem"
+	"github.com/theaniketnegi/go-sys-monitor/internal/devices"
+	"github.com/theaniketnegi/go-sys-monitor/internal/exporter"
+	"github.com/theaniketnegi/go-sys-monitor/internal/i18n"
+	"github.com/theaniketnegi/go-sys-monitor/internal/metrics"
+	"github.com/theaniketnegi/go-sys-monitor/widgets"
 )
 
-type tickMsg time.Time
+const defaultHistoryLength = 60
+
+const historyGraphWidth = 40
+
+const historyGraphHeight = 4
+
+const historyGridColumns = 3
+
+// collectInterval is how often the shared collector goroutine polls
+// gopsutil. cpu.Percent below blocks for roughly this long to measure
+// utilization, so ticking faster than this wouldn't collect anything new.
+const collectInterval = time.Second
+
+// collectTimeout bounds a single collection round so a hung collector (e.g.
+// disk.Usage on a stale NFS mount) can't block the TUI or the exporter
+// forever.
+const collectTimeout = 5 * time.Second
+
+// initialCollectRetries bounds how many times runCollector retries the first
+// collection before giving up: enough to ride out a container's /proc or
+// disk mount not being fully ready yet at process start, without turning a
+// genuinely broken collector into an indefinite hang.
+const initialCollectRetries = 3
+
+// snapshotMsg carries one collection round from the shared collector
+// goroutine into the Bubble Tea update loop.
+type snapshotMsg SystemMetrics
 
 var baseStyle = lipgloss.NewStyle().
 	BorderStyle(lipgloss.NormalBorder()).
@@ -25,17 +59,42 @@ var baseStyle = lipgloss.NewStyle().
 
 var boldPinkStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#FF7CCB")).Bold(true)
 
+// tr is the translation bundle for the locale detected at startup. It's
+// initialized in main() before any of the View()/table-column helpers run.
+var tr *i18n.Bundle
+
 type model struct {
-	sys         SystemMetrics
-	cpuProgress []progress.Model
-	memProgress progress.Model
-	diskTable   table.Model
+	sys             SystemMetrics
+	cpuProgress     []progress.Model
+	memProgress     progress.Model
+	tempProgress    []progress.Model
+	batteryProgress progress.Model
+	diskTable       table.Model
+	netTable        table.Model
+	processTable    table.Model
+
+	netCollector     *metrics.NetCollector
+	processCollector *metrics.ProcessCollector
+
+	cpuHistory     []*widgets.History
+	memHistory     *widgets.History
+	netHistory     map[string]*widgets.History
+	procCPUHistory map[int32]*widgets.History
+	procMemHistory map[int32]*widgets.History
+	historyLen     int
+	graph          widgets.LineGraph
+
+	snapshots <-chan SystemMetrics
 }
 
 type SystemMetrics struct {
-	cpu    CPUMetrics
-	memory MemoryMetrics
-	disk   DiskMetrics
+	cpu     CPUMetrics
+	memory  MemoryMetrics
+	disk    DiskMetrics
+	net     metrics.NetMetrics
+	process metrics.ProcessMetrics
+	temps   []devices.TemperatureSensor
+	battery devices.BatteryStatus
 }
 
 type CPUMetrics struct {
@@ -74,28 +133,25 @@ const (
       (____/                                                                           
 
 	`
-	CPU_TITLE  = "CPU Metrics"
-	MEM_TITLE  = "Memory Metrics"
-	DISK_TITLE = "Disk metrics"
 )
 
-func GetCPUMetrics() (CPUMetrics, error) {
-	stat, err := cpu.Info()
+func GetCPUMetrics(ctx context.Context) (CPUMetrics, error) {
+	stat, err := cpu.InfoWithContext(ctx)
 	if err != nil {
 		return CPUMetrics{}, err
 	}
 
-	total, err := cpu.Counts(true)
+	total, err := cpu.CountsWithContext(ctx, true)
 	if err != nil {
 		return CPUMetrics{}, err
 	}
 
-	logical, err := cpu.Counts(false)
+	logical, err := cpu.CountsWithContext(ctx, false)
 	if err != nil {
 		return CPUMetrics{}, err
 	}
 
-	v, err := cpu.Percent(time.Second, true)
+	v, err := cpu.PercentWithContext(ctx, time.Second, true)
 	if err != nil {
 		return CPUMetrics{}, err
 	}
@@ -103,16 +159,16 @@ func GetCPUMetrics() (CPUMetrics, error) {
 	return CPUMetrics{modelName: stat[0].ModelName, frequency: stat[0].Mhz, totalCPU: total, logicalCPU: logical, percentUsage: v}, nil
 }
 
-func GetMemMetrics() (MemoryMetrics, error) {
-	v, err := mem.VirtualMemory()
+func GetMemMetrics(ctx context.Context) (MemoryMetrics, error) {
+	v, err := mem.VirtualMemoryWithContext(ctx)
 	if err != nil {
 		return MemoryMetrics{}, err
 	}
 	return MemoryMetrics{memoryUsed: v.Used, memoryTotal: v.Total}, nil
 }
 
-func GetDiskMetrics() (DiskMetrics, error) {
-	partitions, err := disk.Partitions(false)
+func GetDiskMetrics(ctx context.Context) (DiskMetrics, error) {
+	partitions, err := disk.PartitionsWithContext(ctx, false)
 
 	if err != nil {
 		return DiskMetrics{}, err
@@ -120,7 +176,7 @@ func GetDiskMetrics() (DiskMetrics, error) {
 
 	var partitionMetrics []MountedPartitionMetrics
 	for _, partition := range partitions {
-		stat, err := disk.Usage(partition.Mountpoint)
+		stat, err := disk.UsageWithContext(ctx, partition.Mountpoint)
 		if err != nil {
 			continue
 		}
@@ -130,10 +186,265 @@ func GetDiskMetrics() (DiskMetrics, error) {
 	return DiskMetrics{partitionMetrics}, nil
 }
 
-func doTick() tea.Cmd {
-	return tea.Tick(50*time.Millisecond, func(t time.Time) tea.Msg {
-		return tickMsg(t)
-	})
+func getProcessTableColumns() []table.Column {
+	return []table.Column{
+		{Title: tr.Value("table.pid"), Width: 10},
+		{Title: tr.Value("table.user"), Width: 15},
+		{Title: tr.Value("table.cpu_percent"), Width: 10},
+		{Title: tr.Value("table.rss"), Width: 10},
+		{Title: tr.Value("table.command"), Width: 30},
+	}
+}
+
+func getProcessTableRows(procMetrics metrics.ProcessMetrics) []table.Row {
+	var rows []table.Row
+
+	for _, p := range procMetrics.Processes {
+		rows = append(rows, table.Row{
+			strconv.Itoa(int(p.PID)), p.User, fmt.Sprintf("%.1f", p.CPUPercent), convertSize(p.RSS), p.Command,
+		})
+	}
+
+	return rows
+}
+
+func getNetTableColumns() []table.Column {
+	return []table.Column{
+		{Title: tr.Value("table.interface"), Width: 20},
+		{Title: tr.Value("table.rx"), Width: 15},
+		{Title: tr.Value("table.tx"), Width: 15},
+	}
+}
+
+func getNetTableRows(netMetrics metrics.NetMetrics) []table.Row {
+	var rows []table.Row
+
+	for _, iface := range netMetrics.Interfaces {
+		rows = append(rows, table.Row{
+			iface.Name, formatRate(iface.RxBytesPS) + "/s", formatRate(iface.TxBytesPS) + "/s",
+		})
+	}
+
+	return rows
+}
+
+// formatRate renders a bytes-per-second rate at whichever unit keeps it
+// readable: one decimal place for K and M, whole bytes below 1K. Unlike convertSize (which
+// floors to whole megabytes for disk/process sizes), network throughput is
+// routinely in the KB/s range, and flooring that to "0M/s" would hide most
+// real activity.
+func formatRate(bytesPerSec float64) string {
+	switch {
+	case bytesPerSec >= 1024*1024:
+		return fmt.Sprintf("%.1f%s", bytesPerSec/(1024*1024), tr.Value("unit.megabyte"))
+	case bytesPerSec >= 1024:
+		return fmt.Sprintf("%.1f%s", bytesPerSec/1024, tr.Value("unit.kilobyte"))
+	default:
+		return fmt.Sprintf("%.0f%s", bytesPerSec, tr.Value("unit.byte"))
+	}
+}
+
+// maxOf returns the largest value in samples, but never less than 1 (so also
+// 1 for empty or all-zero samples), so graphs with no activity yet don't
+// divide by zero.
+func maxOf(samples []float64) float64 {
+	max := 1.0
+	for _, s := range samples {
+		if s > max {
+			max = s
+		}
+	}
+	return max
+}
+
+func renderHistoryGraph(title string, samples []float64, max float64, graph widgets.LineGraph) string {
+	return baseStyle.Render(fmt.Sprintf("%s\n%s", title, graph.Render(samples, max)))
+}
+
+func (m model) renderHistoryGrid() string {
+	var boxes []string
+	for i, h := range m.cpuHistory {
+		values := h.Values()
+		boxes = append(boxes, renderHistoryGraph(fmt.Sprintf(tr.Value("cpu.core_label"), i+1), values, 100, m.graph))
+	}
+
+	boxes = append(boxes, renderHistoryGraph(tr.Value("mem.history_label"), m.memHistory.Values(), 100, m.graph))
+
+	for _, iface := range m.sys.net.Interfaces {
+		values := m.netHistory[iface.Name].Values()
+		boxes = append(boxes, renderHistoryGraph(iface.Name, values, maxOf(values), m.graph))
+	}
+
+	if row := m.processTable.SelectedRow(); row != nil {
+		if pid, err := strconv.Atoi(row[0]); err == nil {
+			if cpuHist, ok := m.procCPUHistory[int32(pid)]; ok {
+				title := fmt.Sprintf(tr.Value("process.pid_cpu_label"), pid)
+				boxes = append(boxes, renderHistoryGraph(title, cpuHist.Values(), 100, m.graph))
+			}
+			if memHist, ok := m.procMemHistory[int32(pid)]; ok {
+				title := fmt.Sprintf(tr.Value("process.pid_mem_label"), pid)
+				boxes = append(boxes, renderHistoryGraph(title, memHist.Values(), 100, m.graph))
+			}
+		}
+	}
+
+	var rows []string
+	for i := 0; i < len(boxes); i += historyGridColumns {
+		end := i + historyGridColumns
+		if end > len(boxes) {
+			end = len(boxes)
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, boxes[i:end]...))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// waitForSnapshot returns a tea.Cmd that blocks until the collector
+// goroutine publishes the next snapshot.
+func waitForSnapshot(snapshots <-chan SystemMetrics) tea.Cmd {
+	return func() tea.Msg {
+		return snapshotMsg(<-snapshots)
+	}
+}
+
+// collectSnapshot gathers one round of CPU, memory, disk, network and
+// process metrics. It bounds the whole round with collectTimeout so a
+// single hung collector (e.g. a stale NFS mount) can't block the caller
+// indefinitely.
+func collectSnapshot(parent context.Context, netCollector *metrics.NetCollector, processCollector *metrics.ProcessCollector) (SystemMetrics, error) {
+	ctx, cancel := context.WithTimeout(parent, collectTimeout)
+	defer cancel()
+
+	cpu, err := GetCPUMetrics(ctx)
+	if err != nil {
+		return SystemMetrics{}, fmt.Errorf("collecting CPU metrics: %w", err)
+	}
+
+	mem, err := GetMemMetrics(ctx)
+	if err != nil {
+		return SystemMetrics{}, fmt.Errorf("collecting memory metrics: %w", err)
+	}
+
+	disk, err := GetDiskMetrics(ctx)
+	if err != nil {
+		return SystemMetrics{}, fmt.Errorf("collecting disk metrics: %w", err)
+	}
+
+	net, err := netCollector.Collect(ctx)
+	if err != nil {
+		return SystemMetrics{}, fmt.Errorf("collecting network metrics: %w", err)
+	}
+
+	proc, err := processCollector.Collect(ctx)
+	if err != nil {
+		return SystemMetrics{}, fmt.Errorf("collecting process metrics: %w", err)
+	}
+
+	// Sensors and batteries simply don't exist on many hosts; that's not a
+	// collection failure, so only log it and carry on with a zero value.
+	temps, err := devices.Temperatures(ctx)
+	if err != nil {
+		log.Println("collector: reading temperature sensors:", err)
+	}
+
+	batt, err := devices.Battery()
+	if err != nil {
+		log.Println("collector: reading battery status:", err)
+	}
+
+	return SystemMetrics{cpu: cpu, memory: mem, disk: disk, net: net, process: proc, temps: temps, battery: batt}, nil
+}
+
+// runCollector polls collectSnapshot on its own goroutine so the TUI and the
+// Prometheus exporter share a single gopsutil poll per interval instead of
+// each driving their own. exp may be nil when the exporter isn't running.
+// The returned channel always holds the most recent snapshot, dropping
+// stale ones a slow consumer hasn't read yet.
+//
+// The first collection runs synchronously so a persistently failing
+// collector (e.g. cpu.Percent/disk.Usage denied in a restricted container)
+// is reported as a startup error instead of leaving callers blocked forever
+// on the first receive from the returned channel.
+func runCollector(ctx context.Context, netCollector *metrics.NetCollector, processCollector *metrics.ProcessCollector, exp *exporter.Exporter) (<-chan SystemMetrics, error) {
+	snapshots := make(chan SystemMetrics, 1)
+
+	publish := func(sys SystemMetrics) {
+		if exp != nil {
+			exp.UpdateCPU(sys.cpu.percentUsage)
+			exp.UpdateMem(sys.memory.memoryUsed, sys.memory.memoryTotal)
+			exp.UpdateDisk(toExporterPartitions(sys.disk))
+			exp.UpdateNet(toExporterInterfaces(sys.net))
+			exp.UpdateProcessCount(len(sys.process.Processes))
+		}
+
+		select {
+		case snapshots <- sys:
+		default:
+			select {
+			case <-snapshots:
+			default:
+			}
+			snapshots <- sys
+		}
+	}
+
+	var initial SystemMetrics
+	var err error
+	for attempt := 0; attempt <= initialCollectRetries; attempt++ {
+		initial, err = collectSnapshot(ctx, netCollector, processCollector)
+		if err == nil {
+			break
+		}
+		if attempt == initialCollectRetries {
+			return nil, err
+		}
+		log.Println("collector: initial collection failed, retrying:", err)
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(collectInterval):
+		}
+	}
+	publish(initial)
+
+	go func() {
+		ticker := time.NewTicker(collectInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			sys, err := collectSnapshot(ctx, netCollector, processCollector)
+			if err != nil {
+				log.Println("collector:", err)
+				continue
+			}
+			publish(sys)
+		}
+	}()
+
+	return snapshots, nil
+}
+
+func toExporterPartitions(d DiskMetrics) []exporter.DiskPartition {
+	partitions := make([]exporter.DiskPartition, 0, len(d.mountedPartitions))
+	for _, p := range d.mountedPartitions {
+		partitions = append(partitions, exporter.DiskPartition{Mount: p.mountPoint, FS: p.fsType, Used: p.usedDisk, Total: p.totalDisk})
+	}
+	return partitions
+}
+
+func toExporterInterfaces(n metrics.NetMetrics) []exporter.NetInterface {
+	interfaces := make([]exporter.NetInterface, 0, len(n.Interfaces))
+	for _, iface := range n.Interfaces {
+		interfaces = append(interfaces, exporter.NetInterface{Name: iface.Name, RxBytesPS: iface.RxBytesPS, TxBytesPS: iface.TxBytesPS})
+	}
+	return interfaces
 }
 
 func NewProgress() progress.Model {
@@ -142,12 +453,12 @@ func NewProgress() progress.Model {
 
 func getDiskTableColumns() []table.Column {
 	return []table.Column{
-		{Title: "Device", Width: 20},
-		{Title: "Mount", Width: 40},
-		{Title: "FS", Width: 10},
-		{Title: "Used", Width: 10},
-		{Title: "Total", Width: 10},
-		{Title: "Free", Width: 10},
+		{Title: tr.Value("table.device"), Width: 20},
+		{Title: tr.Value("table.mount"), Width: 40},
+		{Title: tr.Value("table.fs"), Width: 10},
+		{Title: tr.Value("table.used"), Width: 10},
+		{Title: tr.Value("table.total"), Width: 10},
+		{Title: tr.Value("table.free"), Width: 10},
 	}
 }
 
@@ -158,9 +469,9 @@ func convertSize(size uint64) string {
 	conv = size / (1024 * 1024)
 	if conv >= 1024 {
 		conv /= 1024
-		res = strconv.FormatUint(conv, 10) + "G"
+		res = strconv.FormatUint(conv, 10) + tr.Value("unit.gigabyte")
 	} else {
-		res = strconv.FormatUint(conv, 10) + "M"
+		res = strconv.FormatUint(conv, 10) + tr.Value("unit.megabyte")
 	}
 
 	return res
@@ -178,16 +489,16 @@ func getDiskTableRows(diskMetrics DiskMetrics) []table.Row {
 	return diskTableRow
 }
 
-func initialModel() model {
-	initCpu, err := GetCPUMetrics()
-	if err != nil {
-		log.Fatal("There was some error getting CPU metrics: ", err)
-	}
+func initialModel(historyLen int, netCollector *metrics.NetCollector, processCollector *metrics.ProcessCollector, snapshots <-chan SystemMetrics) model {
+	// Block for the collector goroutine's first snapshot rather than
+	// collecting a second time here, so the TUI and the exporter only ever
+	// poll gopsutil once per interval.
+	initSys := <-snapshots
 
-	initMem, err := GetMemMetrics()
-	if err != nil {
-		log.Fatal("There was some error getting memory metrics: ", err)
-	}
+	initCpu := initSys.cpu
+	initDisk := initSys.disk
+	initNet := initSys.net
+	initProcess := initSys.process
 
 	cpuProgress := make([]progress.Model, len(initCpu.percentUsage))
 
@@ -197,11 +508,13 @@ func initialModel() model {
 
 	memProgress := NewProgress()
 
-	initDisk, err := GetDiskMetrics()
-	if err != nil {
-		log.Fatal("There was some error getting disk metrics: ", err)
+	tempProgress := make([]progress.Model, len(initSys.temps))
+	for i := range tempProgress {
+		tempProgress[i] = NewProgress()
 	}
 
+	batteryProgress := NewProgress()
+
 	t := table.New(
 		table.WithColumns(getDiskTableColumns()),
 		table.WithRows(getDiskTableRows(initDisk)),
@@ -211,16 +524,60 @@ func initialModel() model {
 		}),
 	)
 
+	netTable := table.New(
+		table.WithColumns(getNetTableColumns()),
+		table.WithRows(getNetTableRows(initNet)),
+		table.WithHeight(len(initNet.Interfaces)+1),
+		table.WithStyles(table.Styles{
+			Header: lipgloss.NewStyle().Bold(true),
+		}),
+	)
+
+	processTable := table.New(
+		table.WithColumns(getProcessTableColumns()),
+		table.WithRows(getProcessTableRows(initProcess)),
+		table.WithHeight(15),
+		table.WithFocused(true),
+		table.WithStyles(table.Styles{
+			Header:   lipgloss.NewStyle().Bold(true),
+			Selected: lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#FF7CCB")),
+		}),
+	)
+
+	cpuHistory := make([]*widgets.History, len(initCpu.percentUsage))
+	for i := range cpuHistory {
+		cpuHistory[i] = widgets.NewHistory(historyLen)
+	}
+
+	netHistory := make(map[string]*widgets.History, len(initNet.Interfaces))
+	for _, iface := range initNet.Interfaces {
+		netHistory[iface.Name] = widgets.NewHistory(historyLen)
+	}
+
 	return model{
-		sys:         SystemMetrics{cpu: initCpu, memory: initMem, disk: initDisk},
-		cpuProgress: cpuProgress,
-		memProgress: memProgress,
-		diskTable:   t,
+		sys:              initSys,
+		cpuProgress:      cpuProgress,
+		memProgress:      memProgress,
+		tempProgress:     tempProgress,
+		batteryProgress:  batteryProgress,
+		diskTable:        t,
+		netTable:         netTable,
+		processTable:     processTable,
+		netCollector:     netCollector,
+		processCollector: processCollector,
+		cpuHistory:       cpuHistory,
+		memHistory:       widgets.NewHistory(historyLen),
+		netHistory:       netHistory,
+		procCPUHistory:   make(map[int32]*widgets.History),
+		procMemHistory:   make(map[int32]*widgets.History),
+		historyLen:       historyLen,
+		graph:            widgets.NewLineGraph(historyGraphWidth).WithHeight(historyGraphHeight),
+		snapshots:        snapshots,
 	}
 }
 
 func (m model) Init() tea.Cmd {
-	return doTick()
+	return waitForSnapshot(m.snapshots)
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -235,30 +592,79 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// These keys should exit the program.
 		case "ctrl+c", "q":
 			return m, tea.Quit
-		}
 
-	case tickMsg:
-		cpu, err := GetCPUMetrics()
-		if err != nil {
-			log.Fatal("There was some error getting CPU metrics: ", err)
+		// Cycle the process table's sort column.
+		case "s":
+			m.processCollector.CycleSortColumn()
+			return m, nil
+
+		// Kill the process currently selected in the process table.
+		case "x":
+			if row := m.processTable.SelectedRow(); row != nil {
+				if pid, err := strconv.Atoi(row[0]); err == nil {
+					ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+					_ = metrics.KillProcess(ctx, int32(pid))
+					cancel()
+				}
+			}
+			return m, nil
 		}
 
-		mem, err := GetMemMetrics()
-		if err != nil {
-			log.Fatal("There was some error getting memory metrics: ", err)
+		var cmd tea.Cmd
+		m.processTable, cmd = m.processTable.Update(msg)
+		return m, cmd
+
+	case snapshotMsg:
+		sys := SystemMetrics(msg)
+
+		m.diskTable.SetRows(getDiskTableRows(sys.disk))
+		m.diskTable.SetHeight(len(sys.disk.mountedPartitions) + 1)
+		m.netTable.SetRows(getNetTableRows(sys.net))
+		m.netTable.SetHeight(len(sys.net.Interfaces) + 1)
+		m.processTable.SetRows(getProcessTableRows(sys.process))
+
+		for i, per := range sys.cpu.percentUsage {
+			if i < len(m.cpuHistory) {
+				m.cpuHistory[i].Push(per)
+			}
+		}
+		m.memHistory.Push(float64(sys.memory.memoryUsed) / float64(sys.memory.memoryTotal) * 100)
+		for _, iface := range sys.net.Interfaces {
+			h, ok := m.netHistory[iface.Name]
+			if !ok {
+				h = widgets.NewHistory(m.historyLen)
+				m.netHistory[iface.Name] = h
+			}
+			h.Push(iface.RxBytesPS + iface.TxBytesPS)
 		}
 
-		disk, err := GetDiskMetrics()
-		if err != nil {
-			log.Fatal("There was some error getting disk metrics: ", err)
+		live := make(map[int32]struct{}, len(sys.process.Processes))
+		for _, p := range sys.process.Processes {
+			live[p.PID] = struct{}{}
+
+			cpuHist, ok := m.procCPUHistory[p.PID]
+			if !ok {
+				cpuHist = widgets.NewHistory(m.historyLen)
+				m.procCPUHistory[p.PID] = cpuHist
+			}
+			cpuHist.Push(p.CPUPercent)
+
+			memHist, ok := m.procMemHistory[p.PID]
+			if !ok {
+				memHist = widgets.NewHistory(m.historyLen)
+				m.procMemHistory[p.PID] = memHist
+			}
+			memHist.Push(float64(p.RSS) / float64(sys.memory.memoryTotal) * 100)
+		}
+		for pid := range m.procCPUHistory {
+			if _, ok := live[pid]; !ok {
+				delete(m.procCPUHistory, pid)
+				delete(m.procMemHistory, pid)
+			}
 		}
 
-		m.diskTable.SetRows(getDiskTableRows(disk))
-		m.diskTable.SetHeight(len(disk.mountedPartitions) + 1)
-		m.sys.cpu = cpu
-		m.sys.memory = mem
-		m.sys.disk = disk
-		return m, doTick()
+		m.sys = sys
+		return m, waitForSnapshot(m.snapshots)
 	}
 
 	// Return the updated model to the Bubble Tea runtime for processing.
@@ -270,32 +676,161 @@ func (m model) View() string {
 	var s strings.Builder
 	s.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#FDFF8C")).Render(TITLE))
 	s.WriteString("\n\n")
-	s.WriteString(boldPinkStyle.Render(CPU_TITLE) + "\n")
-	s.WriteString(boldPinkStyle.Render(strings.Repeat("#", len(CPU_TITLE))) + "\n")
-	s.WriteString(fmt.Sprintf("Model Name: %s\n", m.sys.cpu.modelName))
-	s.WriteString(fmt.Sprintf("Frequency: %.2fMHz\n", m.sys.cpu.frequency))
-	s.WriteString(fmt.Sprintf("Total CPU: %d (%d Logical)\n", m.sys.cpu.totalCPU, m.sys.cpu.logicalCPU))
+	cpuTitle := tr.Value("widget.cpu")
+	s.WriteString(boldPinkStyle.Render(cpuTitle) + "\n")
+	s.WriteString(boldPinkStyle.Render(strings.Repeat("#", len(cpuTitle))) + "\n")
+	s.WriteString(fmt.Sprintf("%s: %s\n", tr.Value("cpu.model_name"), m.sys.cpu.modelName))
+	s.WriteString(fmt.Sprintf("%s: %.2fMHz\n", tr.Value("cpu.frequency"), m.sys.cpu.frequency))
+	s.WriteString(fmt.Sprintf("%s: %d (%d Logical)\n", tr.Value("cpu.total"), m.sys.cpu.totalCPU, m.sys.cpu.logicalCPU))
 
 	for i, per := range m.sys.cpu.percentUsage {
-		s.WriteString(fmt.Sprintf("\nCPU %d: ", i+1) + m.cpuProgress[i].ViewAs(per/100))
+		s.WriteString(fmt.Sprintf("\n"+tr.Value("cpu.core_label")+": ", i+1) + m.cpuProgress[i].ViewAs(per/100))
 	}
 
 	s.WriteString("\n\n")
-	s.WriteString(boldPinkStyle.Render(MEM_TITLE) + "\n")
-	s.WriteString(boldPinkStyle.Render(strings.Repeat("#", len(MEM_TITLE))) + "\n")
-	s.WriteString(fmt.Sprintf("Used %d bytes (of %d bytes)\n\n", m.sys.memory.memoryUsed, m.sys.memory.memoryTotal))
+	memTitle := tr.Value("widget.memory")
+	s.WriteString(boldPinkStyle.Render(memTitle) + "\n")
+	s.WriteString(boldPinkStyle.Render(strings.Repeat("#", len(memTitle))) + "\n")
+	s.WriteString(fmt.Sprintf(tr.Value("mem.used_of_total")+"\n\n", m.sys.memory.memoryUsed, m.sys.memory.memoryTotal))
 	s.WriteString(m.memProgress.ViewAs(float64(m.sys.memory.memoryUsed) / float64(m.sys.memory.memoryTotal)))
 
 	s.WriteString("\n\n")
-	s.WriteString(boldPinkStyle.Render(DISK_TITLE) + "\n")
-	s.WriteString(boldPinkStyle.Render(strings.Repeat("#", len(DISK_TITLE))) + "\n")
+	diskTitle := tr.Value("widget.disk")
+	s.WriteString(boldPinkStyle.Render(diskTitle) + "\n")
+	s.WriteString(boldPinkStyle.Render(strings.Repeat("#", len(diskTitle))) + "\n")
 
 	s.WriteString(baseStyle.Render(m.diskTable.View()))
+
+	s.WriteString("\n\n")
+	tempTitle := tr.Value("widget.temperature")
+	s.WriteString(boldPinkStyle.Render(tempTitle) + "\n")
+	s.WriteString(boldPinkStyle.Render(strings.Repeat("#", len(tempTitle))) + "\n")
+
+	if len(m.sys.temps) == 0 {
+		s.WriteString(tr.Value("temp.none") + "\n")
+	}
+	for i, sensor := range m.sys.temps {
+		if i >= len(m.tempProgress) {
+			break
+		}
+		s.WriteString(fmt.Sprintf("\n%s: ", sensor.Name) + m.tempProgress[i].ViewAs(sensor.Celsius/100))
+	}
+
+	s.WriteString("\n\n")
+	batteryTitle := tr.Value("widget.battery")
+	s.WriteString(boldPinkStyle.Render(batteryTitle) + "\n")
+	s.WriteString(boldPinkStyle.Render(strings.Repeat("#", len(batteryTitle))) + "\n")
+
+	if !m.sys.battery.Present {
+		s.WriteString(tr.Value("battery.none") + "\n")
+	} else {
+		s.WriteString(fmt.Sprintf("%s: %s", tr.Value("battery.state_label"), batteryStateLabel(m.sys.battery.State)))
+		if m.sys.battery.State == devices.BatteryDischarging && m.sys.battery.TimeToEmpty > 0 {
+			s.WriteString(fmt.Sprintf(" (%s: %s)", tr.Value("battery.remaining"), m.sys.battery.TimeToEmpty.Round(time.Minute)))
+		}
+		s.WriteString("\n\n")
+		s.WriteString(m.batteryProgress.ViewAs(m.sys.battery.Percent / 100))
+	}
+
+	s.WriteString("\n\n")
+	netTitle := tr.Value("widget.network")
+	s.WriteString(boldPinkStyle.Render(netTitle) + "\n")
+	s.WriteString(boldPinkStyle.Render(strings.Repeat("#", len(netTitle))) + "\n")
+
+	s.WriteString(baseStyle.Render(m.netTable.View()))
+
+	s.WriteString("\n\n")
+	processTitle := tr.Value("widget.processes")
+	s.WriteString(boldPinkStyle.Render(processTitle) + "\n")
+	s.WriteString(boldPinkStyle.Render(strings.Repeat("#", len(processTitle))) + "\n")
+	s.WriteString(tr.Value("process.hint") + "\n")
+
+	s.WriteString(baseStyle.Render(m.processTable.View()))
+
+	s.WriteString("\n\n")
+	historyTitle := tr.Value("widget.history")
+	s.WriteString(boldPinkStyle.Render(historyTitle) + "\n")
+	s.WriteString(boldPinkStyle.Render(strings.Repeat("#", len(historyTitle))) + "\n")
+	s.WriteString(m.renderHistoryGrid())
 	return s.String()
 }
 
+// batteryStateLabel translates a devices.BatteryState into the active
+// locale's display string.
+func batteryStateLabel(state devices.BatteryState) string {
+	switch state {
+	case devices.BatteryCharging:
+		return tr.Value("battery.state.charging")
+	case devices.BatteryDischarging:
+		return tr.Value("battery.state.discharging")
+	case devices.BatteryFull:
+		return tr.Value("battery.state.full")
+	default:
+		return tr.Value("battery.state.unknown")
+	}
+}
+
 func main() {
-	p := tea.NewProgram(initialModel())
+	historyLen := flag.Int("history", defaultHistoryLength, "number of samples to retain for the CPU/memory/network history graphs")
+	serve := flag.String("serve", "", "address to serve a Prometheus /metrics endpoint on, e.g. :9090 (disabled when empty)")
+	noTUI := flag.Bool("no-tui", false, "run the exporter without the Bubble Tea program; requires -serve")
+	locale := flag.String("locale", "", "locale to display the TUI in, e.g. en_US (defaults to the LC_ALL/LANG environment locale)")
+	list := flag.String("list", "", "list available options and exit (supported: locales)")
+	flag.Parse()
+
+	if *list != "" {
+		if *list != "locales" {
+			log.Fatalf("-list: unsupported option %q (supported: locales)", *list)
+		}
+		locales, err := i18n.ListLocales()
+		if err != nil {
+			log.Fatal("listing locales: ", err)
+		}
+		for _, l := range locales {
+			fmt.Println(l)
+		}
+		return
+	}
+
+	if *noTUI && *serve == "" {
+		log.Fatal("-no-tui requires -serve")
+	}
+
+	if *locale == "" {
+		*locale = i18n.DetectLocale()
+	}
+	var err error
+	tr, err = i18n.Load(*locale)
+	if err != nil {
+		log.Fatal("loading locale: ", err)
+	}
+
+	var exp *exporter.Exporter
+	if *serve != "" {
+		exp = exporter.New()
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", exp.Handler())
+		go func() {
+			if err := http.ListenAndServe(*serve, mux); err != nil {
+				log.Fatal("exporter: ", err)
+			}
+		}()
+	}
+
+	ctx := context.Background()
+	netCollector := metrics.NewNetCollector()
+	processCollector := metrics.NewProcessCollector()
+	snapshots, err := runCollector(ctx, netCollector, processCollector, exp)
+	if err != nil {
+		log.Fatal("collector: ", err)
+	}
+
+	if *noTUI {
+		select {}
+	}
+
+	p := tea.NewProgram(initialModel(*historyLen, netCollector, processCollector, snapshots))
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("Alas, there's been an error: %v", err)
 		os.Exit(1)
@@ -0,0 +1,31 @@
+// Package widgets provides rendering helpers for the TUI that go beyond
+// what bubbles/progress and bubbles/table offer directly, such as
+// sparkline-style history graphs.
+package widgets
+
+// History is a fixed-length ring buffer of the most recent samples for a
+// single metric (one CPU core, memory, a network interface, ...).
+type History struct {
+	samples  []float64
+	capacity int
+}
+
+// NewHistory returns an empty History that retains at most capacity
+// samples.
+func NewHistory(capacity int) *History {
+	return &History{capacity: capacity}
+}
+
+// Push appends a sample, dropping the oldest one once capacity is
+// exceeded.
+func (h *History) Push(v float64) {
+	h.samples = append(h.samples, v)
+	if len(h.samples) > h.capacity {
+		h.samples = h.samples[len(h.samples)-h.capacity:]
+	}
+}
+
+// Values returns the samples currently held, oldest first.
+func (h *History) Values() []float64 {
+	return h.samples
+}
@@ -0,0 +1,32 @@
+package widgets
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHistoryPush(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		pushes   []float64
+		want     []float64
+	}{
+		{"under capacity keeps all samples", 3, []float64{1, 2}, []float64{1, 2}},
+		{"at capacity keeps all samples", 3, []float64{1, 2, 3}, []float64{1, 2, 3}},
+		{"over capacity drops the oldest", 3, []float64{1, 2, 3, 4}, []float64{2, 3, 4}},
+		{"empty", 3, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHistory(tt.capacity)
+			for _, v := range tt.pushes {
+				h.Push(v)
+			}
+			if got := h.Values(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Values() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package widgets
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// sparkBlocks are the block glyphs used to render a sample as one of eight
+// height levels, lowest to highest.
+var sparkBlocks = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// brailleLeftDots and brailleRightDots are the dot bitmasks (relative to the
+// U+2800 braille block base) for the left and right column of a braille
+// cell, ordered bottom-to-top so a fill level of n turns on the bottom n
+// dots - the same "rises from the baseline" look as the block glyphs.
+var brailleLeftDots = []rune{0x40, 0x04, 0x02, 0x01}
+var brailleRightDots = []rune{0x80, 0x20, 0x10, 0x08}
+
+// GlyphSet selects the characters LineGraph uses to render a sample.
+type GlyphSet int
+
+const (
+	// GlyphBlocks renders one sample per column using the eight-level block
+	// glyphs above.
+	GlyphBlocks GlyphSet = iota
+	// GlyphBraille packs two samples into each column using a braille
+	// cell's left/right dot columns, roughly doubling the horizontal
+	// resolution at the cost of vertical precision (4 levels vs. 8).
+	GlyphBraille
+)
+
+// LineGraph renders a []float64 history as a sparkline or small multi-row
+// graph, colored with the same pink/yellow gradient used by the progress
+// bars.
+type LineGraph struct {
+	Width  int
+	Height int
+	Glyphs GlyphSet
+
+	rampA, rampB colorful.Color
+}
+
+// NewLineGraph returns a LineGraph that renders width glyphs wide and one
+// row tall using block glyphs. Use the With* options to change that.
+func NewLineGraph(width int) LineGraph {
+	a, _ := colorful.Hex("#FF7CCB")
+	b, _ := colorful.Hex("#FDFF8C")
+	return LineGraph{
+		Width:  width,
+		Height: 1,
+		Glyphs: GlyphBlocks,
+		rampA:  a,
+		rampB:  b,
+	}
+}
+
+// WithHeight returns a copy of g that renders height rows instead of one,
+// trading a single sparkline line for a small bar-chart-style graph.
+func (g LineGraph) WithHeight(height int) LineGraph {
+	g.Height = height
+	return g
+}
+
+// WithGlyphs returns a copy of g using the given glyph set.
+func (g LineGraph) WithGlyphs(glyphs GlyphSet) LineGraph {
+	g.Glyphs = glyphs
+	return g
+}
+
+// Render draws the most recent samples, scaled against max, as a sparkline
+// (Height == 1) or a multi-row graph (Height > 1). Samples beyond Width (or
+// 2*Width in GlyphBraille, which packs two samples per column) are dropped
+// from the front; a graph with fewer samples than that is left-padded with
+// blanks so the width stays fixed. GlyphBraille ignores Height and always
+// renders a single row.
+func (g LineGraph) Render(samples []float64, max float64) string {
+	if g.Glyphs == GlyphBraille {
+		return g.renderBraille(samples, max)
+	}
+	return g.renderBlocks(samples, max)
+}
+
+func (g LineGraph) renderBlocks(samples []float64, max float64) string {
+	if len(samples) > g.Width {
+		samples = samples[len(samples)-g.Width:]
+	}
+
+	height := g.Height
+	if height < 1 {
+		height = 1
+	}
+	totalSubLevels := height * len(sparkBlocks)
+	levels := make([]int, 0, g.Width)
+	for i := 0; i < g.Width-len(samples); i++ {
+		levels = append(levels, -1)
+	}
+	for _, s := range samples {
+		levels = append(levels, 1+int(ratioOf(s, max)*float64(totalSubLevels-1)))
+	}
+
+	rows := make([]string, height)
+	for row := 0; row < height; row++ {
+		// rowFloor is the sub-level just below the range this row covers.
+		// Rows are indexed from the top (row 0) down, so the bottom row's
+		// rowFloor is 0.
+		rowFloor := (height - 1 - row) * len(sparkBlocks)
+		glyphs := make([]rune, len(levels))
+		for i, level := range levels {
+			switch {
+			case level < 0:
+				glyphs[i] = ' '
+			case level <= rowFloor:
+				glyphs[i] = ' '
+			case level >= rowFloor+len(sparkBlocks):
+				glyphs[i] = sparkBlocks[len(sparkBlocks)-1]
+			default:
+				glyphs[i] = sparkBlocks[level-rowFloor-1]
+			}
+		}
+		rows[row] = g.colorize(glyphs)
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+func (g LineGraph) renderBraille(samples []float64, max float64) string {
+	capacity := g.Width * 2
+	if len(samples) > capacity {
+		samples = samples[len(samples)-capacity:]
+	}
+	blanks := capacity - len(samples)
+
+	glyphs := make([]rune, g.Width)
+	for i := range glyphs {
+		leftIdx, rightIdx := i*2, i*2+1
+
+		var cell rune = 0x2800
+		if leftIdx >= blanks {
+			cell += brailleDotsFor(samples[leftIdx-blanks], max, brailleLeftDots)
+		}
+		if rightIdx >= blanks {
+			cell += brailleDotsFor(samples[rightIdx-blanks], max, brailleRightDots)
+		}
+		glyphs[i] = cell
+	}
+
+	return g.colorize(glyphs)
+}
+
+func brailleDotsFor(sample, max float64, dots []rune) rune {
+	level := int(ratioOf(sample, max) * float64(len(dots)))
+	var mask rune
+	for i := 0; i < level; i++ {
+		mask |= dots[i]
+	}
+	return mask
+}
+
+// ratioOf clamps sample/max to [0, 1], treating a non-positive max as "no
+// signal yet" so graphs without data don't divide by zero.
+func ratioOf(sample, max float64) float64 {
+	if max <= 0 {
+		return 0
+	}
+	ratio := sample / max
+	switch {
+	case ratio < 0:
+		return 0
+	case ratio > 1:
+		return 1
+	default:
+		return ratio
+	}
+}
+
+// colorize renders glyphs left-to-right, blending from rampA to rampB the
+// same way the progress bars do.
+func (g LineGraph) colorize(glyphs []rune) string {
+	var b strings.Builder
+	n := len(glyphs)
+	for i, r := range glyphs {
+		p := 0.0
+		if n > 1 {
+			p = float64(i) / float64(n-1)
+		}
+		color := g.rampA.BlendLuv(g.rampB, p).Hex()
+		b.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render(string(r)))
+	}
+	return b.String()
+}
@@ -0,0 +1,50 @@
+package widgets
+
+import "testing"
+
+func TestBrailleDotsFor(t *testing.T) {
+	tests := []struct {
+		name   string
+		sample float64
+		max    float64
+		dots   []rune
+		want   rune
+	}{
+		{"no signal", 0, 0, brailleLeftDots, 0},
+		{"zero sample", 0, 100, brailleLeftDots, 0},
+		{"full sample lights every dot", 100, 100, brailleLeftDots, 0x40 | 0x04 | 0x02 | 0x01},
+		{"full sample right column", 100, 100, brailleRightDots, 0x80 | 0x20 | 0x10 | 0x08},
+		{"half sample lights the bottom half", 50, 100, brailleLeftDots, 0x40 | 0x04},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := brailleDotsFor(tt.sample, tt.max, tt.dots); got != tt.want {
+				t.Errorf("brailleDotsFor(%v, %v, dots) = %#x, want %#x", tt.sample, tt.max, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRatioOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		sample float64
+		max    float64
+		want   float64
+	}{
+		{"no max means no signal yet", 5, 0, 0},
+		{"negative max means no signal yet", 5, -1, 0},
+		{"mid range", 25, 100, 0.25},
+		{"clamped below zero", -10, 100, 0},
+		{"clamped above one", 150, 100, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ratioOf(tt.sample, tt.max); got != tt.want {
+				t.Errorf("ratioOf(%v, %v) = %v, want %v", tt.sample, tt.max, got, tt.want)
+			}
+		})
+	}
+}